@@ -0,0 +1,106 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/gateway-api/gwctl/pkg/importers"
+	_ "sigs.k8s.io/gateway-api/gwctl/pkg/importers/ingress" // self-registers the "ingress" provider
+)
+
+// previewOptions holds the flags for `gwctl preview`.
+type previewOptions struct {
+	provider string
+	fromDir  string
+}
+
+// NewPreviewCommand returns the `gwctl preview` command, which runs an
+// importers.Provider against the manifests in the directory given by
+// --from-dir and shows the synthetic Gateway API resources it would create -
+// without writing anything to the cluster. It does not list objects from a
+// live cluster; --from-dir is required.
+func NewPreviewCommand(out io.Writer) *cobra.Command {
+	o := &previewOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "preview",
+		Short: "Preview the Gateway API resources an importer would create",
+		Long: "Preview runs a registered importer (see `gwctl preview --provider`) against its " +
+			"inputs and prints the synthetic Gateway API resources it would create, without " +
+			"applying anything to the cluster.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return o.run(out)
+		},
+	}
+
+	cmd.Flags().StringVar(&o.provider, "provider", "ingress", "Name of the importer provider to run")
+	cmd.Flags().StringVar(&o.fromDir, "from-dir", "", "Directory of YAML manifests to read instead of a live cluster")
+	_ = cmd.MarkFlagRequired("from-dir") // live-cluster listing isn't available in gwctl yet
+
+	return cmd
+}
+
+func (o *previewOptions) run(out io.Writer) error {
+	provider, ok := importers.Get(o.provider)
+	if !ok {
+		return fmt.Errorf("no importer provider registered as %q", o.provider)
+	}
+
+	objects, err := importers.ReadObjectsFromDir(o.fromDir)
+	if err != nil {
+		return fmt.Errorf("reading input manifests: %w", err)
+	}
+
+	result, err := provider.Import(objects)
+	if err != nil {
+		return fmt.Errorf("running %q provider: %w", o.provider, err)
+	}
+
+	printPreviewResult(out, result)
+	return nil
+}
+
+// printPreviewResult renders a Result in the plain tabular style `gwctl
+// get` uses elsewhere, until the existing printers are extended to accept
+// synthetic nodes directly.
+func printPreviewResult(out io.Writer, result *importers.Result) {
+	fmt.Fprintln(out, "GATEWAYS")
+	for _, gatewayNode := range result.Gateways {
+		fmt.Fprintf(out, "  %s/%s (class %s)\n", gatewayNode.Gateway.Namespace, gatewayNode.Gateway.Name, gatewayNode.Gateway.Spec.GatewayClassName)
+	}
+
+	fmt.Fprintln(out, "HTTPROUTES")
+	for _, httpRouteNode := range result.HTTPRoutes {
+		fmt.Fprintf(out, "  %s/%s\n", httpRouteNode.HTTPRoute.Namespace, httpRouteNode.HTTPRoute.Name)
+	}
+
+	fmt.Fprintln(out, "BACKENDS")
+	for _, backendNode := range result.Backends {
+		fmt.Fprintf(out, "  %s/%s\n", backendNode.Backend.GetNamespace(), backendNode.Backend.GetName())
+	}
+
+	if len(result.Errors) > 0 {
+		fmt.Fprintln(out, "ADVISORY ERRORS")
+		for _, err := range result.Errors {
+			fmt.Fprintf(out, "  %v\n", err)
+		}
+	}
+}
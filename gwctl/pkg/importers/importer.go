@@ -0,0 +1,74 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package importers seeds a resourcediscovery graph from non-Gateway-API
+// sources, such as Ingress objects, the way ingress2gateway converts
+// cluster-specific Ingress resources into Gateway API resources. Each
+// source is implemented as a Provider so new ones can be added without
+// touching the callers.
+package importers
+
+import (
+	"sigs.k8s.io/gateway-api/gwctl/pkg/resourcediscovery"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Result is the synthetic Gateway API graph produced by a Provider. The
+// nodes it contains were never read from the cluster's Gateway API objects,
+// so callers should clearly mark them as a preview rather than live state.
+type Result struct {
+	Gateways   []*resourcediscovery.GatewayNode
+	HTTPRoutes []*resourcediscovery.HTTPRouteNode
+	Backends   []*resourcediscovery.BackendNode
+
+	// Errors describes input features the provider could not translate,
+	// e.g. a provider-specific annotation with no Gateway API equivalent.
+	// These are advisory: the rest of Result is still usable.
+	Errors []error
+}
+
+// Provider converts objects from a foreign source into a synthetic Gateway
+// API Result. Implementations are expected to be pure and side-effect free
+// so they can run against either a live cluster listing or a directory of
+// YAML files.
+type Provider interface {
+	// Name identifies the provider, e.g. "ingress".
+	Name() string
+	// Import converts objects into a synthetic Gateway API Result.
+	Import(objects []runtime.Object) (*Result, error)
+}
+
+// providers holds the registered Providers, keyed by Provider.Name().
+var providers = map[string]Provider{}
+
+// Register adds a Provider so it can be looked up by name, e.g. from the
+// `gwctl preview` command's `--provider` flag. It panics on a duplicate name
+// since that indicates two providers were wired up under the same
+// identifier, which is a programming error.
+func Register(provider Provider) {
+	name := provider.Name()
+	if _, ok := providers[name]; ok {
+		panic("importers: provider already registered: " + name)
+	}
+	providers[name] = provider
+}
+
+// Get looks up a registered Provider by name.
+func Get(name string) (Provider, bool) {
+	provider, ok := providers[name]
+	return provider, ok
+}
@@ -0,0 +1,250 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ingress implements an importers.Provider that converts
+// Kubernetes Ingress objects into a synthetic Gateway API graph, the same
+// translation ingress2gateway performs, plus advisory errors for
+// provider-specific annotations (GCE FrontendConfig/BackendConfig, Kong,
+// Traefik) that have no Gateway API equivalent.
+package ingress
+
+import (
+	"fmt"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/gwctl/pkg/importers"
+	"sigs.k8s.io/gateway-api/gwctl/pkg/resourcediscovery"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// unsupportedAnnotations flags provider-specific annotations that steer
+// Ingress controller behavior in ways Gateway API has no direct equivalent
+// for, so the importer can surface them as advisory errors instead of
+// silently dropping the behavior they configure.
+var unsupportedAnnotations = []string{
+	"networking.gke.io/v1beta1.FrontendConfig",
+	"cloud.google.com/backend-config",
+	"konghq.com/plugins",
+	"traefik.ingress.kubernetes.io/router.middlewares",
+}
+
+// Provider implements importers.Provider for Kubernetes Ingress resources.
+type Provider struct{}
+
+// NewProvider returns an ingress importers.Provider.
+func NewProvider() *Provider { return &Provider{} }
+
+func init() {
+	importers.Register(NewProvider())
+}
+
+func (p *Provider) Name() string { return "ingress" }
+
+// Import converts the given Ingress objects into a synthetic Gateway API
+// Result. Non-Ingress objects are ignored.
+func (p *Provider) Import(objects []runtime.Object) (*importers.Result, error) {
+	result := &importers.Result{}
+	gateways := map[string]*resourcediscovery.GatewayNode{}
+	backends := map[string]*resourcediscovery.BackendNode{}
+
+	for _, object := range objects {
+		ingress, err := toIngress(object)
+		if err != nil {
+			return nil, err
+		}
+		if ingress == nil {
+			continue
+		}
+
+		for annotation := range ingress.Annotations {
+			if isUnsupportedAnnotation(annotation) {
+				result.Errors = append(result.Errors, fmt.Errorf(
+					"Ingress %s/%s: annotation %q has no Gateway API equivalent and was ignored",
+					ingress.Namespace, ingress.Name, annotation))
+			}
+		}
+
+		gatewayNode := gatewayForIngress(ingress, gateways)
+		httpRoute := httpRouteForIngress(ingress, gatewayNode)
+		result.HTTPRoutes = append(result.HTTPRoutes, httpRoute)
+
+		attachBackend := func(backend networkingv1.IngressBackend) {
+			backendNode := backendForIngress(ingress, backend, backends)
+			if backendNode == nil {
+				return
+			}
+			httpRoute.Backends[backendNode.ID()] = backendNode
+			backendNode.HTTPRoutes[httpRoute.ID()] = httpRoute
+		}
+
+		for _, rule := range ingress.Spec.Rules {
+			if rule.HTTP == nil {
+				continue
+			}
+			for _, path := range rule.HTTP.Paths {
+				attachBackend(path.Backend)
+			}
+		}
+
+		if ingress.Spec.DefaultBackend != nil {
+			// defaultBackend has no path/host match of its own - Gateway API
+			// has no "catch-all, lowest priority" backendRef, so this is
+			// surfaced as an advisory error rather than silently dropped or
+			// misrepresented as a normal rule.
+			attachBackend(*ingress.Spec.DefaultBackend)
+			result.Errors = append(result.Errors, fmt.Errorf(
+				"Ingress %s/%s: spec.defaultBackend has no direct Gateway API equivalent; "+
+					"its Service backend, if any, was added to HTTPRoute %s/%s but the catch-all match was not translated",
+				ingress.Namespace, ingress.Name, ingress.Namespace, ingress.Name))
+		}
+	}
+
+	for _, gatewayNode := range gateways {
+		result.Gateways = append(result.Gateways, gatewayNode)
+	}
+	for _, backendNode := range backends {
+		result.Backends = append(result.Backends, backendNode)
+	}
+
+	return result, nil
+}
+
+func toIngress(object runtime.Object) (*networkingv1.Ingress, error) {
+	switch o := object.(type) {
+	case *networkingv1.Ingress:
+		return o, nil
+	case *unstructured.Unstructured:
+		if o.GroupVersionKind().Kind != "Ingress" {
+			return nil, nil
+		}
+		ingress := &networkingv1.Ingress{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.Object, ingress); err != nil {
+			return nil, fmt.Errorf("converting %s/%s to Ingress: %w", o.GetNamespace(), o.GetName(), err)
+		}
+		return ingress, nil
+	default:
+		return nil, nil
+	}
+}
+
+func isUnsupportedAnnotation(annotation string) bool {
+	for _, unsupported := range unsupportedAnnotations {
+		if annotation == unsupported {
+			return true
+		}
+	}
+	return false
+}
+
+// gatewayIngressClass is the IngressClass a Gateway is synthesized for,
+// since Gateway API has no notion of a default class-wide Gateway the way
+// Ingress does.
+func gatewayIngressClass(ingress *networkingv1.Ingress) string {
+	if ingress.Spec.IngressClassName != nil {
+		return *ingress.Spec.IngressClassName
+	}
+	return "default"
+}
+
+// gatewayCacheKey identifies the synthesized Gateway an Ingress maps to. A
+// Gateway is namespaced, so two Ingresses that share an IngressClass but
+// live in different namespaces must synthesize distinct Gateways - keying
+// solely by class would otherwise leak one namespace's Gateway into
+// another's HTTPRoute.ParentRefs.
+func gatewayCacheKey(ingress *networkingv1.Ingress) string {
+	return fmt.Sprintf("%s/%s", ingress.Namespace, gatewayIngressClass(ingress))
+}
+
+func gatewayForIngress(ingress *networkingv1.Ingress, gateways map[string]*resourcediscovery.GatewayNode) *resourcediscovery.GatewayNode {
+	key := gatewayCacheKey(ingress)
+	if gatewayNode, ok := gateways[key]; ok {
+		return gatewayNode
+	}
+
+	class := gatewayIngressClass(ingress)
+	gateway := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ingress.Namespace,
+			Name:      fmt.Sprintf("%s-gateway", class),
+		},
+		Spec: gatewayv1.GatewaySpec{
+			GatewayClassName: gatewayv1.ObjectName(class),
+			Listeners: []gatewayv1.Listener{{
+				Name:     "http",
+				Protocol: gatewayv1.HTTPProtocolType,
+				Port:     80,
+			}},
+		},
+	}
+	gatewayNode := resourcediscovery.NewGatewayNode(gateway)
+	gateways[key] = gatewayNode
+	return gatewayNode
+}
+
+func httpRouteForIngress(ingress *networkingv1.Ingress, gatewayNode *resourcediscovery.GatewayNode) *resourcediscovery.HTTPRouteNode {
+	var hostnames []gatewayv1.Hostname
+	for _, rule := range ingress.Spec.Rules {
+		if rule.Host != "" {
+			hostnames = append(hostnames, gatewayv1.Hostname(rule.Host))
+		}
+	}
+
+	gatewayNamespace := gatewayv1.Namespace(gatewayNode.Gateway.Namespace)
+	httpRoute := &gatewayv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ingress.Namespace,
+			Name:      ingress.Name,
+		},
+		Spec: gatewayv1.HTTPRouteSpec{
+			Hostnames: hostnames,
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{
+				ParentRefs: []gatewayv1.ParentReference{{
+					Name:      gatewayv1.ObjectName(gatewayNode.Gateway.Name),
+					Namespace: &gatewayNamespace,
+				}},
+			},
+		},
+	}
+	httpRouteNode := resourcediscovery.NewHTTPRouteNode(httpRoute)
+	httpRouteNode.Gateways[gatewayNode.ID()] = gatewayNode
+	gatewayNode.HTTPRoutes[httpRouteNode.ID()] = httpRouteNode
+	return httpRouteNode
+}
+
+func backendForIngress(ingress *networkingv1.Ingress, backend networkingv1.IngressBackend, backends map[string]*resourcediscovery.BackendNode) *resourcediscovery.BackendNode {
+	if backend.Service == nil {
+		return nil
+	}
+
+	key := fmt.Sprintf("%s/%s", ingress.Namespace, backend.Service.Name)
+	if backendNode, ok := backends[key]; ok {
+		return backendNode
+	}
+
+	object := &unstructured.Unstructured{}
+	object.SetAPIVersion("v1")
+	object.SetKind("Service")
+	object.SetNamespace(ingress.Namespace)
+	object.SetName(backend.Service.Name)
+
+	backendNode := resourcediscovery.NewBackendNode(object)
+	backends[key] = backendNode
+	return backendNode
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingress
+
+import (
+	"testing"
+
+	"sigs.k8s.io/gateway-api/gwctl/pkg/importers"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func serviceBackend(name string) networkingv1.IngressBackend {
+	return networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{Name: name}}
+}
+
+func TestImport_SameClassDifferentNamespaceGetsDistinctGateways(t *testing.T) {
+	ingressA := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "a"},
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{{
+				Host: "a.example.com",
+				IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+					Paths: []networkingv1.HTTPIngressPath{{Backend: serviceBackend("svc-a")}},
+				}},
+			}},
+		},
+	}
+	ingressB := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "team-b", Name: "b"},
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{{
+				Host: "b.example.com",
+				IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+					Paths: []networkingv1.HTTPIngressPath{{Backend: serviceBackend("svc-b")}},
+				}},
+			}},
+		},
+	}
+
+	result, err := NewProvider().Import([]runtime.Object{ingressA, ingressB})
+	if err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+
+	if len(result.Gateways) != 2 {
+		t.Fatalf("expected 2 distinct Gateways (one per namespace), got %d", len(result.Gateways))
+	}
+	for _, gatewayNode := range result.Gateways {
+		if gatewayNode.Gateway.Namespace != "team-a" && gatewayNode.Gateway.Namespace != "team-b" {
+			t.Errorf("unexpected Gateway namespace %q", gatewayNode.Gateway.Namespace)
+		}
+	}
+
+	for _, httpRoute := range result.HTTPRoutes {
+		parentRefs := httpRoute.HTTPRoute.Spec.ParentRefs
+		if len(parentRefs) != 1 || parentRefs[0].Namespace == nil {
+			t.Fatalf("expected HTTPRoute %s/%s to have a namespaced parentRef, got %+v", httpRoute.HTTPRoute.Namespace, httpRoute.HTTPRoute.Name, parentRefs)
+		}
+		if string(*parentRefs[0].Namespace) != httpRoute.HTTPRoute.Namespace {
+			t.Errorf("HTTPRoute %s/%s parentRef points at namespace %q, want its own namespace %q",
+				httpRoute.HTTPRoute.Namespace, httpRoute.HTTPRoute.Name, *parentRefs[0].Namespace, httpRoute.HTTPRoute.Namespace)
+		}
+	}
+}
+
+func TestImport_DefaultBackend(t *testing.T) {
+	defaultBackend := serviceBackend("catch-all")
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "my-ingress"},
+		Spec:       networkingv1.IngressSpec{DefaultBackend: &defaultBackend},
+	}
+
+	result, err := NewProvider().Import([]runtime.Object{ingress})
+	if err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+
+	if len(result.Backends) != 1 || result.Backends[0].Backend.GetName() != "catch-all" {
+		t.Fatalf("expected defaultBackend's Service to be surfaced as a Backend, got %+v", result.Backends)
+	}
+	if len(result.Errors) != 1 {
+		t.Fatalf("expected an advisory error noting defaultBackend isn't fully translated, got %v", result.Errors)
+	}
+}
+
+func TestImport_UnsupportedAnnotation(t *testing.T) {
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "app",
+			Name:        "my-ingress",
+			Annotations: map[string]string{"konghq.com/plugins": "rate-limit"},
+		},
+	}
+
+	result, err := NewProvider().Import([]runtime.Object{ingress})
+	if err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+	if len(result.Errors) != 1 {
+		t.Fatalf("expected 1 advisory error for the unsupported annotation, got %v", result.Errors)
+	}
+}
+
+func TestProviderIsSelfRegistered(t *testing.T) {
+	if _, ok := importers.Get("ingress"); !ok {
+		t.Fatalf(`expected the ingress Provider to self-register under "ingress"`)
+	}
+}
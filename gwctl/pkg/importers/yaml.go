@@ -0,0 +1,76 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package importers
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// ReadObjectsFromDir reads every .yaml/.yml file in dir (non-recursively)
+// and decodes each YAML document into an unstructured object. It lets
+// `gwctl preview` seed a Provider from a directory of manifests instead of
+// requiring a live cluster.
+func ReadObjectsFromDir(dir string) ([]runtime.Object, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading directory %q: %w", dir, err)
+	}
+
+	var objects []runtime.Object
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		ext := strings.ToLower(filepath.Ext(entry.Name()))
+		if ext != ".yaml" && ext != ".yml" {
+			continue
+		}
+
+		path := filepath.Join(dir, entry.Name())
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("opening %q: %w", path, err)
+		}
+
+		decoder := k8syaml.NewYAMLOrJSONDecoder(file, 4096)
+		for {
+			u := &unstructured.Unstructured{}
+			if err := decoder.Decode(u); err != nil {
+				if err == io.EOF {
+					break
+				}
+				file.Close()
+				return nil, fmt.Errorf("decoding %q: %w", path, err)
+			}
+			if len(u.Object) == 0 {
+				continue // skip empty documents produced by a trailing "---"
+			}
+			objects = append(objects, u)
+		}
+		file.Close()
+	}
+
+	return objects, nil
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcediscovery
+
+import "fmt"
+
+// PopulateBackendTLSPolicyLinks resolves policyNode's targetRefs and
+// caCertificateRefs against the Backends and ConfigMaps already discovered in
+// the same namespace, wiring the bidirectional links both sides read
+// (BackendTLSPolicyNode.Backends/CACertRefs and
+// BackendNode.BackendTLSPolicies/ConfigMapNode.BackendTLSPolicies) and
+// recording an error on policyNode for anything that doesn't resolve.
+//
+// BackendTLSPolicy also allows caCertificateRefs to name a Secret, but this
+// tree has no SecretNode type to link against yet, so Secret-kind refs are
+// reported as an error rather than silently dropped.
+func PopulateBackendTLSPolicyLinks(policyNode *BackendTLSPolicyNode, backends map[backendID]*BackendNode, configMaps map[configMapID]*ConfigMapNode) {
+	if policyNode.BackendTLSPolicy == nil {
+		return
+	}
+	namespace := policyNode.BackendTLSPolicy.Namespace
+
+	for _, targetRef := range policyNode.BackendTLSPolicy.Spec.TargetRefs {
+		kind := "Service"
+		if targetRef.Kind != "" {
+			kind = string(targetRef.Kind)
+		}
+		backendNode, ok := backends[BackendID(string(targetRef.Group), kind, namespace, string(targetRef.Name))]
+		if !ok {
+			policyNode.Errors = append(policyNode.Errors, fmt.Errorf(
+				"BackendTLSPolicy %s/%s: targetRef %s %q not found in namespace %q",
+				namespace, policyNode.BackendTLSPolicy.Name, kind, targetRef.Name, namespace))
+			continue
+		}
+		policyNode.Backends[backendNode.ID()] = backendNode
+		backendNode.BackendTLSPolicies[policyNode.ID()] = policyNode
+	}
+
+	for _, caCertRef := range policyNode.BackendTLSPolicy.Spec.Validation.CACertificateRefs {
+		kind := "ConfigMap"
+		if caCertRef.Kind != "" {
+			kind = string(caCertRef.Kind)
+		}
+		if kind != "ConfigMap" {
+			policyNode.Errors = append(policyNode.Errors, fmt.Errorf(
+				"BackendTLSPolicy %s/%s: caCertificateRefs kind %q is not linked by this tree (only ConfigMap is modeled)",
+				namespace, policyNode.BackendTLSPolicy.Name, kind))
+			continue
+		}
+
+		configMapNode, ok := configMaps[ConfigMapID(namespace, string(caCertRef.Name))]
+		if !ok {
+			policyNode.Errors = append(policyNode.Errors, fmt.Errorf(
+				"BackendTLSPolicy %s/%s: caCertificateRefs ConfigMap %q not found in namespace %q",
+				namespace, policyNode.BackendTLSPolicy.Name, caCertRef.Name, namespace))
+			continue
+		}
+		policyNode.CACertRefs[configMapNode.ID()] = configMapNode
+		configMapNode.BackendTLSPolicies[policyNode.ID()] = policyNode
+	}
+}
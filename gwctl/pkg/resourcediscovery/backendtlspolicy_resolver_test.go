@@ -0,0 +1,105 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcediscovery
+
+import (
+	"testing"
+
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1alpha3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestPopulateBackendTLSPolicyLinks(t *testing.T) {
+	backendObject := &unstructured.Unstructured{}
+	backendObject.SetAPIVersion("v1")
+	backendObject.SetKind("Service")
+	backendObject.SetNamespace("app")
+	backendObject.SetName("svc")
+	backendNode := NewBackendNode(backendObject)
+	backends := map[backendID]*BackendNode{backendNode.ID(): backendNode}
+
+	configMapNode := NewConfigMapNode(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "ca-bundle"},
+	})
+	configMaps := map[configMapID]*ConfigMapNode{configMapNode.ID(): configMapNode}
+
+	policyNode := NewBackendTLSPolicyNode(&gatewayv1alpha3.BackendTLSPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "policy"},
+		Spec: gatewayv1alpha3.BackendTLSPolicySpec{
+			TargetRefs: []gatewayv1alpha2.LocalPolicyTargetReferenceWithSectionName{{
+				LocalPolicyTargetReference: gatewayv1alpha2.LocalPolicyTargetReference{
+					Kind: "Service",
+					Name: "svc",
+				},
+			}},
+			Validation: gatewayv1alpha3.BackendTLSPolicyValidation{
+				CACertificateRefs: []gatewayv1alpha2.LocalObjectReference{{
+					Kind: "ConfigMap",
+					Name: "ca-bundle",
+				}},
+			},
+		},
+	})
+
+	PopulateBackendTLSPolicyLinks(policyNode, backends, configMaps)
+
+	if _, ok := policyNode.Backends[backendNode.ID()]; !ok {
+		t.Fatalf("expected policyNode.Backends to contain the resolved Service backend")
+	}
+	if _, ok := backendNode.BackendTLSPolicies[policyNode.ID()]; !ok {
+		t.Fatalf("expected backendNode.BackendTLSPolicies to link back to the policy")
+	}
+	if _, ok := policyNode.CACertRefs[configMapNode.ID()]; !ok {
+		t.Fatalf("expected policyNode.CACertRefs to contain the resolved ConfigMap")
+	}
+	if _, ok := configMapNode.BackendTLSPolicies[policyNode.ID()]; !ok {
+		t.Fatalf("expected configMapNode.BackendTLSPolicies to link back to the policy")
+	}
+	if len(policyNode.Errors) != 0 {
+		t.Fatalf("expected no resolution errors, got %v", policyNode.Errors)
+	}
+}
+
+func TestPopulateBackendTLSPolicyLinks_UnresolvedRefsRecordErrors(t *testing.T) {
+	policyNode := NewBackendTLSPolicyNode(&gatewayv1alpha3.BackendTLSPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "policy"},
+		Spec: gatewayv1alpha3.BackendTLSPolicySpec{
+			TargetRefs: []gatewayv1alpha2.LocalPolicyTargetReferenceWithSectionName{{
+				LocalPolicyTargetReference: gatewayv1alpha2.LocalPolicyTargetReference{
+					Kind: "Service",
+					Name: "missing",
+				},
+			}},
+			Validation: gatewayv1alpha3.BackendTLSPolicyValidation{
+				CACertificateRefs: []gatewayv1alpha2.LocalObjectReference{{
+					Kind: "Secret",
+					Name: "tls-secret",
+				}},
+			},
+		},
+	})
+
+	PopulateBackendTLSPolicyLinks(policyNode, map[backendID]*BackendNode{}, map[configMapID]*ConfigMapNode{})
+
+	if len(policyNode.Errors) != 2 {
+		t.Fatalf("expected 2 errors (unresolved targetRef + unlinked Secret kind), got %v", policyNode.Errors)
+	}
+}
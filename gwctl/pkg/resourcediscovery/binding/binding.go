@@ -0,0 +1,321 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package binding evaluates how a route binds to a Gateway's listeners
+// without touching a live cluster, so that `gwctl` can explain exactly why a
+// route is or isn't attached. It mirrors the binder/result pattern used by
+// Consul's API Gateway integration: every parentRef and backendRef is
+// evaluated independently and the result records the concrete reason for
+// rejection rather than just a boolean.
+package binding
+
+import (
+	"fmt"
+	"strings"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// RouteRef identifies the route a binding result belongs to, independent of
+// the concrete route Kind (HTTPRoute, GRPCRoute, TCPRoute, TLSRoute, or
+// UDPRoute).
+type RouteRef struct {
+	Group     string
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+// RouteContext holds the fields EvaluateBinding needs from a route. It's
+// kept independent of any one route Kind so the same evaluator serves
+// HTTPRoute, GRPCRoute, TCPRoute, TLSRoute, and UDPRoute alike.
+type RouteContext struct {
+	Route      RouteRef
+	Hostnames  []gatewayv1.Hostname
+	ParentRefs []gatewayv1.ParentReference
+}
+
+// BackendRefContext pairs a backendRef with whether the referenced Backend
+// was actually found during discovery. Whether it crosses a namespace
+// boundary requiring a ReferenceGrant is derived from BackendRef.Namespace
+// at evaluation time, not stored here.
+type BackendRefContext struct {
+	BackendRef gatewayv1.BackendRef
+	Found      bool
+}
+
+// ParentRefBindingResult captures whether a route was Accepted against a
+// single parentRef/listener, and if not, the concrete reason why - mirroring
+// the per-listener detail Gateway API records in RouteParentStatus.
+type ParentRefBindingResult struct {
+	Route     RouteRef
+	ParentRef gatewayv1.ParentReference
+	Accepted  bool
+	Reason    gatewayv1.RouteConditionReason
+	Message   string
+}
+
+// BackendRefBindingResult captures whether a backendRef resolved to a real
+// Backend, mirroring the ResolvedRefs condition.
+type BackendRefBindingResult struct {
+	BackendRef gatewayv1.BackendRef
+	Resolved   bool
+	Reason     gatewayv1.RouteConditionReason
+	Message    string
+}
+
+// RouteBindingResult is the outcome of evaluating a route against a single
+// Gateway: one entry per parentRef that references it, plus one entry per
+// backendRef the route declares.
+type RouteBindingResult struct {
+	ParentRefs  []ParentRefBindingResult
+	BackendRefs []BackendRefBindingResult
+}
+
+// EvaluateBinding is a pure function that determines whether route binds to
+// gateway and, for every parentRef/backendRef, why it did or didn't. It
+// performs no I/O, so it can be unit tested without a live cluster.
+func EvaluateBinding(
+	route RouteContext,
+	gateway *gatewayv1.Gateway,
+	refGrants []*gatewayv1beta1.ReferenceGrant,
+	backendRefs []BackendRefContext,
+) RouteBindingResult {
+	var result RouteBindingResult
+
+	for _, parentRef := range route.ParentRefs {
+		if !parentRefMatchesGateway(parentRef, route.Route.Namespace, gateway) {
+			continue
+		}
+		result.ParentRefs = append(result.ParentRefs, evaluateParentRef(route, gateway, parentRef))
+	}
+
+	for _, backendRef := range backendRefs {
+		result.BackendRefs = append(result.BackendRefs, evaluateBackendRef(route.Route, backendRef, refGrants))
+	}
+
+	return result
+}
+
+// parentRefMatchesGateway reports whether parentRef names gateway, resolving
+// the implicit "same namespace as the route" default.
+func parentRefMatchesGateway(parentRef gatewayv1.ParentReference, routeNamespace string, gateway *gatewayv1.Gateway) bool {
+	if parentRef.Name != gatewayv1.ObjectName(gateway.Name) {
+		return false
+	}
+	namespace := routeNamespace
+	if parentRef.Namespace != nil {
+		namespace = string(*parentRef.Namespace)
+	}
+	return namespace == gateway.Namespace
+}
+
+func evaluateParentRef(route RouteContext, gateway *gatewayv1.Gateway, parentRef gatewayv1.ParentReference) ParentRefBindingResult {
+	base := ParentRefBindingResult{Route: route.Route, ParentRef: parentRef}
+
+	listeners := matchingListeners(gateway, parentRef)
+	if len(listeners) == 0 {
+		base.Reason = gatewayv1.RouteReasonNoMatchingParent
+		base.Message = fmt.Sprintf("no listener named %q found on Gateway %s/%s", sectionNameOrEmpty(parentRef), gateway.Namespace, gateway.Name)
+		return base
+	}
+
+	var rejections []string
+	reason := gatewayv1.RouteReasonNotAllowedByListeners
+	for _, listener := range listeners {
+		if !allowsKind(listener, route.Route.Group, route.Route.Kind) {
+			rejections = append(rejections, fmt.Sprintf("listener %q does not allow kind %s", listener.Name, route.Route.Kind))
+			continue
+		}
+		if !allowsNamespace(gateway, listener, route.Route.Namespace) {
+			rejections = append(rejections, fmt.Sprintf("listener %q does not allow namespace %q", listener.Name, route.Route.Namespace))
+			continue
+		}
+		if !hostnamesIntersect(listener.Hostname, route.Hostnames) {
+			rejections = append(rejections, fmt.Sprintf("listener %q hostname does not match route hostnames", listener.Name))
+			reason = gatewayv1.RouteReasonNoMatchingListenerHostname
+			continue
+		}
+		base.Accepted = true
+		base.Reason = gatewayv1.RouteReasonAccepted
+		return base
+	}
+
+	base.Reason = reason
+	base.Message = strings.Join(rejections, "; ")
+	return base
+}
+
+func evaluateBackendRef(route RouteRef, backendRef BackendRefContext, refGrants []*gatewayv1beta1.ReferenceGrant) BackendRefBindingResult {
+	result := BackendRefBindingResult{BackendRef: backendRef.BackendRef}
+
+	if !backendRef.Found {
+		result.Reason = gatewayv1.RouteReasonBackendNotFound
+		result.Message = fmt.Sprintf("backendRef %s not found", backendRefName(backendRef.BackendRef))
+		return result
+	}
+
+	backendNamespace := route.Namespace
+	if backendRef.BackendRef.Namespace != nil {
+		backendNamespace = string(*backendRef.BackendRef.Namespace)
+	}
+	if backendNamespace != route.Namespace && !referenceGrantAllows(refGrants, route, backendRef.BackendRef, backendNamespace) {
+		result.Reason = gatewayv1.RouteReasonRefNotPermitted
+		result.Message = fmt.Sprintf("no ReferenceGrant allows a %s in namespace %q to reference a backend in namespace %q", route.Kind, route.Namespace, backendNamespace)
+		return result
+	}
+
+	result.Resolved = true
+	result.Reason = gatewayv1.RouteReasonResolvedRefs
+	return result
+}
+
+func matchingListeners(gateway *gatewayv1.Gateway, parentRef gatewayv1.ParentReference) []gatewayv1.Listener {
+	if parentRef.SectionName == nil || *parentRef.SectionName == "" {
+		return gateway.Spec.Listeners
+	}
+	for _, listener := range gateway.Spec.Listeners {
+		if listener.Name == *parentRef.SectionName {
+			return []gatewayv1.Listener{listener}
+		}
+	}
+	return nil
+}
+
+func allowsKind(listener gatewayv1.Listener, group, kind string) bool {
+	if listener.AllowedRoutes == nil || len(listener.AllowedRoutes.Kinds) == 0 {
+		return true // defaults to the listener's protocol-implied kind; permissive here.
+	}
+	for _, allowed := range listener.AllowedRoutes.Kinds {
+		if string(allowed.Kind) == kind && (allowed.Group == nil || string(*allowed.Group) == group) {
+			return true
+		}
+	}
+	return false
+}
+
+func allowsNamespace(gateway *gatewayv1.Gateway, listener gatewayv1.Listener, routeNamespace string) bool {
+	if listener.AllowedRoutes == nil || listener.AllowedRoutes.Namespaces == nil {
+		return routeNamespace == gateway.Namespace
+	}
+	switch from := listener.AllowedRoutes.Namespaces.From; {
+	case from == nil || *from == gatewayv1.NamespacesFromSame:
+		return routeNamespace == gateway.Namespace
+	case *from == gatewayv1.NamespacesFromAll:
+		return true
+	case *from == gatewayv1.NamespacesFromSelector:
+		// Selector evaluation requires a live Namespace lookup; callers that
+		// care about this case should pre-filter before calling
+		// EvaluateBinding. Treat as allowed here so the rest of the
+		// pipeline still runs.
+		return true
+	default:
+		return false
+	}
+}
+
+func hostnamesIntersect(listenerHostname *gatewayv1.Hostname, routeHostnames []gatewayv1.Hostname) bool {
+	if listenerHostname == nil || *listenerHostname == "" || len(routeHostnames) == 0 {
+		return true
+	}
+	for _, routeHostname := range routeHostnames {
+		if hostnameMatches(string(*listenerHostname), string(routeHostname)) {
+			return true
+		}
+	}
+	return false
+}
+
+// hostnameMatches implements the Gateway API hostname intersection rules,
+// including a single leading wildcard label on either side.
+func hostnameMatches(a, b string) bool {
+	if a == b {
+		return true
+	}
+	aWildcard, aRest := strings.CutPrefix(a, "*.")
+	bWildcard, bRest := strings.CutPrefix(b, "*.")
+	switch {
+	case aWildcard && bWildcard:
+		return true
+	case aWildcard:
+		return matchesWildcardSuffix(b, aRest)
+	case bWildcard:
+		return matchesWildcardSuffix(a, bRest)
+	default:
+		return false
+	}
+}
+
+// matchesWildcardSuffix reports whether hostname is a strict subdomain of
+// "*.<rest>" - i.e. "foo.rest" matches but "rest" (the apex) and
+// "evilrest" (sharing only a string suffix, not a label boundary) do not.
+func matchesWildcardSuffix(hostname, rest string) bool {
+	if hostname == rest {
+		return false
+	}
+	return strings.HasSuffix(hostname, "."+rest)
+}
+
+func referenceGrantAllows(refGrants []*gatewayv1beta1.ReferenceGrant, route RouteRef, backendRef gatewayv1.BackendRef, backendNamespace string) bool {
+	group, kind := "", "Service"
+	if backendRef.Group != nil {
+		group = string(*backendRef.Group)
+	}
+	if backendRef.Kind != nil {
+		kind = string(*backendRef.Kind)
+	}
+	for _, refGrant := range refGrants {
+		if refGrant.Namespace != backendNamespace {
+			continue
+		}
+		if !refGrantFromMatches(refGrant, route) {
+			continue
+		}
+		for _, to := range refGrant.Spec.To {
+			if string(to.Group) == group && string(to.Kind) == kind {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func refGrantFromMatches(refGrant *gatewayv1beta1.ReferenceGrant, route RouteRef) bool {
+	for _, from := range refGrant.Spec.From {
+		if string(from.Kind) == route.Kind && string(from.Namespace) == route.Namespace {
+			return true
+		}
+	}
+	return false
+}
+
+func sectionNameOrEmpty(parentRef gatewayv1.ParentReference) string {
+	if parentRef.SectionName == nil {
+		return ""
+	}
+	return string(*parentRef.SectionName)
+}
+
+func backendRefName(backendRef gatewayv1.BackendRef) types.NamespacedName {
+	namespace := ""
+	if backendRef.Namespace != nil {
+		namespace = string(*backendRef.Namespace)
+	}
+	return types.NamespacedName{Namespace: namespace, Name: string(backendRef.Name)}
+}
@@ -0,0 +1,262 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"testing"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func sectionName(name string) *gatewayv1.SectionName {
+	s := gatewayv1.SectionName(name)
+	return &s
+}
+
+func hostname(name string) *gatewayv1.Hostname {
+	h := gatewayv1.Hostname(name)
+	return &h
+}
+
+func namespaceName(name string) *gatewayv1.Namespace {
+	n := gatewayv1.Namespace(name)
+	return &n
+}
+
+func testGateway() *gatewayv1.Gateway {
+	return &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "infra", Name: "gw"},
+		Spec: gatewayv1.GatewaySpec{
+			Listeners: []gatewayv1.Listener{
+				{
+					Name:     "http",
+					Protocol: gatewayv1.HTTPProtocolType,
+					Hostname: hostname("foo.example.com"),
+					AllowedRoutes: &gatewayv1.AllowedRoutes{
+						Namespaces: &gatewayv1.RouteNamespaces{
+							From: func() *gatewayv1.FromNamespaces { f := gatewayv1.NamespacesFromAll; return &f }(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestEvaluateBinding_Accepted(t *testing.T) {
+	gateway := testGateway()
+	route := RouteContext{
+		Route:     RouteRef{Kind: "HTTPRoute", Namespace: "app", Name: "my-route"},
+		Hostnames: []gatewayv1.Hostname{"foo.example.com"},
+		ParentRefs: []gatewayv1.ParentReference{
+			{Name: "gw", Namespace: namespaceName("infra"), SectionName: sectionName("http")},
+		},
+	}
+
+	result := EvaluateBinding(route, gateway, nil, nil)
+
+	if len(result.ParentRefs) != 1 {
+		t.Fatalf("expected 1 parentRef result, got %d", len(result.ParentRefs))
+	}
+	if !result.ParentRefs[0].Accepted {
+		t.Errorf("expected route to be accepted, got reason %q message %q", result.ParentRefs[0].Reason, result.ParentRefs[0].Message)
+	}
+}
+
+func TestEvaluateBinding_NoMatchingListener(t *testing.T) {
+	gateway := testGateway()
+	route := RouteContext{
+		Route: RouteRef{Kind: "HTTPRoute", Namespace: "app", Name: "my-route"},
+		ParentRefs: []gatewayv1.ParentReference{
+			{Name: "gw", Namespace: namespaceName("infra"), SectionName: sectionName("missing")},
+		},
+	}
+
+	result := EvaluateBinding(route, gateway, nil, nil)
+
+	if len(result.ParentRefs) != 1 {
+		t.Fatalf("expected 1 parentRef result, got %d", len(result.ParentRefs))
+	}
+	if result.ParentRefs[0].Accepted {
+		t.Errorf("expected route to be rejected")
+	}
+	if result.ParentRefs[0].Reason != gatewayv1.RouteReasonNoMatchingParent {
+		t.Errorf("reason = %q, want %q", result.ParentRefs[0].Reason, gatewayv1.RouteReasonNoMatchingParent)
+	}
+}
+
+func TestEvaluateBinding_HostnameMismatch(t *testing.T) {
+	gateway := testGateway()
+	route := RouteContext{
+		Route:     RouteRef{Kind: "HTTPRoute", Namespace: "app", Name: "my-route"},
+		Hostnames: []gatewayv1.Hostname{"bar.example.com"},
+		ParentRefs: []gatewayv1.ParentReference{
+			{Name: "gw", Namespace: namespaceName("infra"), SectionName: sectionName("http")},
+		},
+	}
+
+	result := EvaluateBinding(route, gateway, nil, nil)
+
+	if result.ParentRefs[0].Accepted {
+		t.Errorf("expected route to be rejected for hostname mismatch")
+	}
+	if result.ParentRefs[0].Reason != gatewayv1.RouteReasonNoMatchingListenerHostname {
+		t.Errorf("reason = %q, want %q", result.ParentRefs[0].Reason, gatewayv1.RouteReasonNoMatchingListenerHostname)
+	}
+}
+
+func TestEvaluateBinding_BackendNotFound(t *testing.T) {
+	gateway := testGateway()
+	route := RouteContext{Route: RouteRef{Kind: "HTTPRoute", Namespace: "app", Name: "my-route"}}
+	backendRefs := []BackendRefContext{
+		{BackendRef: gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "missing-svc"}}, Found: false},
+	}
+
+	result := EvaluateBinding(route, gateway, nil, backendRefs)
+
+	if len(result.BackendRefs) != 1 {
+		t.Fatalf("expected 1 backendRef result, got %d", len(result.BackendRefs))
+	}
+	if result.BackendRefs[0].Resolved {
+		t.Errorf("expected backendRef to be unresolved")
+	}
+	if result.BackendRefs[0].Reason != gatewayv1.RouteReasonBackendNotFound {
+		t.Errorf("reason = %q, want %q", result.BackendRefs[0].Reason, gatewayv1.RouteReasonBackendNotFound)
+	}
+}
+
+func TestEvaluateBinding_CrossNamespaceBackendRequiresReferenceGrant(t *testing.T) {
+	gateway := testGateway()
+	route := RouteContext{Route: RouteRef{Kind: "HTTPRoute", Namespace: "app", Name: "my-route"}}
+	backendRefs := []BackendRefContext{
+		{
+			BackendRef: gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{
+				Name:      "svc",
+				Namespace: namespaceName("other"),
+			}},
+			Found: true,
+		},
+	}
+
+	withoutGrant := EvaluateBinding(route, gateway, nil, backendRefs)
+	if withoutGrant.BackendRefs[0].Resolved {
+		t.Errorf("expected backendRef to be rejected without a ReferenceGrant")
+	}
+	if withoutGrant.BackendRefs[0].Reason != gatewayv1.RouteReasonRefNotPermitted {
+		t.Errorf("reason = %q, want %q", withoutGrant.BackendRefs[0].Reason, gatewayv1.RouteReasonRefNotPermitted)
+	}
+
+	refGrant := &gatewayv1beta1.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "grant"},
+		Spec: gatewayv1beta1.ReferenceGrantSpec{
+			From: []gatewayv1beta1.ReferenceGrantFrom{{Kind: "HTTPRoute", Namespace: "app"}},
+			To:   []gatewayv1beta1.ReferenceGrantTo{{Kind: "Service"}},
+		},
+	}
+	withGrant := EvaluateBinding(route, gateway, []*gatewayv1beta1.ReferenceGrant{refGrant}, backendRefs)
+	if !withGrant.BackendRefs[0].Resolved {
+		t.Errorf("expected backendRef to be resolved once a ReferenceGrant allows it")
+	}
+}
+
+func TestEvaluateBinding_ReferenceGrantGroupMustMatch(t *testing.T) {
+	gateway := testGateway()
+	route := RouteContext{Route: RouteRef{Kind: "HTTPRoute", Namespace: "app", Name: "my-route"}}
+	backendRefs := []BackendRefContext{
+		{
+			BackendRef: gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{
+				Name:      "svc",
+				Namespace: namespaceName("other"),
+			}},
+			Found: true,
+		},
+	}
+
+	// The grant's "To" names the same Kind but a different Group than the
+	// backendRef (which defaults to the core "" group) - it must not permit
+	// the reference.
+	wrongGroupRef := gatewayv1beta1.Group("example.com")
+	refGrant := &gatewayv1beta1.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "grant"},
+		Spec: gatewayv1beta1.ReferenceGrantSpec{
+			From: []gatewayv1beta1.ReferenceGrantFrom{{Kind: "HTTPRoute", Namespace: "app"}},
+			To:   []gatewayv1beta1.ReferenceGrantTo{{Group: wrongGroupRef, Kind: "Service"}},
+		},
+	}
+
+	result := EvaluateBinding(route, gateway, []*gatewayv1beta1.ReferenceGrant{refGrant}, backendRefs)
+	if result.BackendRefs[0].Resolved {
+		t.Errorf("expected backendRef to be rejected since the ReferenceGrant's To.Group doesn't match")
+	}
+}
+
+func TestHostnameMatches(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want bool
+	}{
+		{name: "exact match", a: "foo.example.com", b: "foo.example.com", want: true},
+		{name: "wildcard matches subdomain", a: "*.example.com", b: "foo.example.com", want: true},
+		{name: "wildcard matches deeper subdomain", a: "*.example.com", b: "foo.bar.example.com", want: true},
+		{name: "wildcard does not match apex", a: "*.example.com", b: "example.com", want: false},
+		{name: "wildcard does not match unrelated suffix sharing no label boundary", a: "*.example.com", b: "evilexample.com", want: false},
+		{name: "wildcard does not match unrelated domain", a: "*.example.com", b: "example.org", want: false},
+		{name: "both wildcards always intersect", a: "*.example.com", b: "*.example.org", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hostnameMatches(tt.a, tt.b); got != tt.want {
+				t.Errorf("hostnameMatches(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+			if got := hostnameMatches(tt.b, tt.a); got != tt.want {
+				t.Errorf("hostnameMatches(%q, %q) = %v, want %v (symmetric)", tt.b, tt.a, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluateBinding_WildcardListenerHostname(t *testing.T) {
+	gateway := testGateway()
+	gateway.Spec.Listeners[0].Hostname = hostname("*.example.com")
+
+	accepted := EvaluateBinding(RouteContext{
+		Route:     RouteRef{Kind: "HTTPRoute", Namespace: "app", Name: "my-route"},
+		Hostnames: []gatewayv1.Hostname{"foo.example.com"},
+		ParentRefs: []gatewayv1.ParentReference{
+			{Name: "gw", Namespace: namespaceName("infra"), SectionName: sectionName("http")},
+		},
+	}, gateway, nil, nil)
+	if !accepted.ParentRefs[0].Accepted {
+		t.Errorf("expected foo.example.com to match *.example.com listener")
+	}
+
+	rejected := EvaluateBinding(RouteContext{
+		Route:     RouteRef{Kind: "HTTPRoute", Namespace: "app", Name: "my-route"},
+		Hostnames: []gatewayv1.Hostname{"evilexample.com"},
+		ParentRefs: []gatewayv1.ParentReference{
+			{Name: "gw", Namespace: namespaceName("infra"), SectionName: sectionName("http")},
+		},
+	}, gateway, nil, nil)
+	if rejected.ParentRefs[0].Accepted {
+		t.Errorf("expected evilexample.com not to match *.example.com listener")
+	}
+}
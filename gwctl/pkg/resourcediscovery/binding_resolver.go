@@ -0,0 +1,100 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcediscovery
+
+import (
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/gateway-api/gwctl/pkg/resourcediscovery/binding"
+)
+
+// PopulateHTTPRouteBindingResults evaluates httpRouteNode against every
+// Gateway it's attached to, recording the outcome on
+// httpRouteNode.BindingResults and on each target GatewayNode's
+// ListenerBindings, so `gwctl describe gateway/httproute` can explain
+// exactly why a route is or isn't attached instead of just echoing status
+// conditions. refGrants should contain every ReferenceGrant visible to the
+// backends' namespaces.
+func PopulateHTTPRouteBindingResults(httpRouteNode *HTTPRouteNode, refGrants []*gatewayv1beta1.ReferenceGrant) {
+	if httpRouteNode.HTTPRoute == nil {
+		return
+	}
+
+	routeContext := binding.RouteContext{
+		Route: binding.RouteRef{
+			Group:     gatewayv1.GroupName,
+			Kind:      "HTTPRoute",
+			Namespace: httpRouteNode.HTTPRoute.Namespace,
+			Name:      httpRouteNode.HTTPRoute.Name,
+		},
+		Hostnames:  httpRouteNode.HTTPRoute.Spec.Hostnames,
+		ParentRefs: httpRouteNode.HTTPRoute.Spec.ParentRefs,
+	}
+
+	backendRefs := httpBackendRefContexts(httpRouteNode)
+
+	evaluateAndStoreBindings(routeContext, backendRefs, refGrants, httpRouteNode.Gateways, func(gwID gatewayID, result binding.RouteBindingResult) {
+		httpRouteNode.BindingResults[gwID] = result
+	})
+}
+
+// evaluateAndStoreBindings runs EvaluateBinding against every Gateway a route
+// is attached to, recording each result via store and fanning the per-parentRef
+// results back out to the target GatewayNode's ListenerBindings. It's shared
+// by every route Kind's Populate*BindingResults function since EvaluateBinding
+// itself is Kind-agnostic.
+func evaluateAndStoreBindings(
+	routeContext binding.RouteContext,
+	backendRefs []binding.BackendRefContext,
+	refGrants []*gatewayv1beta1.ReferenceGrant,
+	gateways map[gatewayID]*GatewayNode,
+	store func(gatewayID, binding.RouteBindingResult),
+) {
+	for _, gatewayNode := range gateways {
+		if gatewayNode.Gateway == nil {
+			continue
+		}
+
+		result := binding.EvaluateBinding(routeContext, gatewayNode.Gateway, refGrants, backendRefs)
+		store(gatewayNode.ID(), result)
+
+		for _, parentRefResult := range result.ParentRefs {
+			section := sectionNameOf(parentRefResult.ParentRef)
+			gatewayNode.ListenerBindings[section] = append(gatewayNode.ListenerBindings[section], parentRefResult)
+		}
+	}
+}
+
+// httpBackendRefContexts flattens the backendRefs declared across an
+// HTTPRoute's rules, resolving each against the Backends discovery already
+// attached to httpRouteNode.
+func httpBackendRefContexts(httpRouteNode *HTTPRouteNode) []binding.BackendRefContext {
+	var backendRefs []binding.BackendRefContext
+	for _, rule := range httpRouteNode.HTTPRoute.Spec.Rules {
+		for _, backendRef := range rule.BackendRefs {
+			backendRefs = append(backendRefs, resolveBackendRefContext(backendRef.BackendRef, httpRouteNode.HTTPRoute.Namespace, httpRouteNode.Backends))
+		}
+	}
+	return backendRefs
+}
+
+func sectionNameOf(parentRef gatewayv1.ParentReference) gatewayv1.SectionName {
+	if parentRef.SectionName == nil {
+		return ""
+	}
+	return *parentRef.SectionName
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcediscovery
+
+import (
+	"testing"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPopulateHTTPRouteBindingResults(t *testing.T) {
+	sectionName := gatewayv1.SectionName("http")
+	fromAll := gatewayv1.NamespacesFromAll
+	gateway := NewGatewayNode(&gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "infra", Name: "gw"},
+		Spec: gatewayv1.GatewaySpec{
+			Listeners: []gatewayv1.Listener{{
+				Name:     sectionName,
+				Protocol: gatewayv1.HTTPProtocolType,
+				AllowedRoutes: &gatewayv1.AllowedRoutes{
+					Namespaces: &gatewayv1.RouteNamespaces{From: &fromAll},
+				},
+			}},
+		},
+	})
+
+	namespace := gatewayv1.Namespace("infra")
+	httpRoute := NewHTTPRouteNode(&gatewayv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "my-route"},
+		Spec: gatewayv1.HTTPRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{
+				ParentRefs: []gatewayv1.ParentReference{{
+					Name:        "gw",
+					Namespace:   &namespace,
+					SectionName: &sectionName,
+				}},
+			},
+		},
+	})
+	httpRoute.Gateways[gateway.ID()] = gateway
+
+	PopulateHTTPRouteBindingResults(httpRoute, nil)
+
+	result, ok := httpRoute.BindingResults[gateway.ID()]
+	if !ok {
+		t.Fatalf("expected a BindingResult keyed by the Gateway's ID")
+	}
+	if len(result.ParentRefs) != 1 || !result.ParentRefs[0].Accepted {
+		t.Fatalf("expected the route to be accepted by the Gateway, got %+v", result.ParentRefs)
+	}
+
+	listenerBindings := gateway.ListenerBindings[sectionName]
+	if len(listenerBindings) != 1 || !listenerBindings[0].Accepted {
+		t.Fatalf("expected GatewayNode.ListenerBindings[%q] to record the accepted route, got %+v", sectionName, listenerBindings)
+	}
+}
@@ -23,8 +23,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1alpha3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
 	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 	"sigs.k8s.io/gateway-api/gwctl/pkg/policymanager"
+	"sigs.k8s.io/gateway-api/gwctl/pkg/resourcediscovery/binding"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -45,13 +48,19 @@ func (r resourceID) String() string {
 }
 
 type (
-	gatewayClassID   resourceID
-	namespaceID      resourceID
-	gatewayID        resourceID
-	httpRouteID      resourceID
-	backendID        resourceID
-	referenceGrantID resourceID
-	policyID         resourceID
+	gatewayClassID     resourceID
+	namespaceID        resourceID
+	gatewayID          resourceID
+	httpRouteID        resourceID
+	grpcRouteID        resourceID
+	tcpRouteID         resourceID
+	tlsRouteID         resourceID
+	udpRouteID         resourceID
+	backendID          resourceID
+	referenceGrantID   resourceID
+	policyID           resourceID
+	configMapID        resourceID
+	backendTLSPolicyID resourceID
 )
 
 // GatewayClassID returns an ID for a GatewayClass.
@@ -83,6 +92,38 @@ func HTTPRouteID(namespace, name string) httpRouteID { //nolint:revive
 	return httpRouteID(resourceID{Namespace: namespace, Name: name})
 }
 
+// GRPCRouteID returns an ID for a GRPCRoute.
+func GRPCRouteID(namespace, name string) grpcRouteID { //nolint:revive
+	if namespace == "" {
+		namespace = metav1.NamespaceDefault
+	}
+	return grpcRouteID(resourceID{Namespace: namespace, Name: name})
+}
+
+// TCPRouteID returns an ID for a TCPRoute.
+func TCPRouteID(namespace, name string) tcpRouteID { //nolint:revive
+	if namespace == "" {
+		namespace = metav1.NamespaceDefault
+	}
+	return tcpRouteID(resourceID{Namespace: namespace, Name: name})
+}
+
+// TLSRouteID returns an ID for a TLSRoute.
+func TLSRouteID(namespace, name string) tlsRouteID { //nolint:revive
+	if namespace == "" {
+		namespace = metav1.NamespaceDefault
+	}
+	return tlsRouteID(resourceID{Namespace: namespace, Name: name})
+}
+
+// UDPRouteID returns an ID for a UDPRoute.
+func UDPRouteID(namespace, name string) udpRouteID { //nolint:revive
+	if namespace == "" {
+		namespace = metav1.NamespaceDefault
+	}
+	return udpRouteID(resourceID{Namespace: namespace, Name: name})
+}
+
 // BackendID returns an ID for a Backend.
 func BackendID(group, kind, namespace, name string) backendID { //nolint:revive
 	return backendID(resourceID{
@@ -117,6 +158,22 @@ func ReferenceGrantID(namespace, name string) referenceGrantID { //nolint:revive
 	})
 }
 
+// ConfigMapID returns an ID for a ConfigMap.
+func ConfigMapID(namespace, name string) configMapID { //nolint:revive
+	if namespace == "" {
+		namespace = metav1.NamespaceDefault
+	}
+	return configMapID(resourceID{Namespace: namespace, Name: name})
+}
+
+// BackendTLSPolicyID returns an ID for a BackendTLSPolicy.
+func BackendTLSPolicyID(namespace, name string) backendTLSPolicyID { //nolint:revive
+	if namespace == "" {
+		namespace = metav1.NamespaceDefault
+	}
+	return backendTLSPolicyID(resourceID{Namespace: namespace, Name: name})
+}
+
 // MarshalText is used to implement encoding.TextMarshaler interface for
 // gatewayID.
 func (g gatewayID) MarshalText() ([]byte, error) {
@@ -164,6 +221,14 @@ type GatewayNode struct {
 	GatewayClass *GatewayClassNode
 	// HTTPRoutes stores HTTPRoutes attached to this Gateway.
 	HTTPRoutes map[httpRouteID]*HTTPRouteNode
+	// GRPCRoutes stores GRPCRoutes attached to this Gateway.
+	GRPCRoutes map[grpcRouteID]*GRPCRouteNode
+	// TCPRoutes stores TCPRoutes attached to this Gateway.
+	TCPRoutes map[tcpRouteID]*TCPRouteNode
+	// TLSRoutes stores TLSRoutes attached to this Gateway.
+	TLSRoutes map[tlsRouteID]*TLSRouteNode
+	// UDPRoutes stores UDPRoutes attached to this Gateway.
+	UDPRoutes map[udpRouteID]*UDPRouteNode
 	// Policies stores Policies directly applied to the Gateway.
 	Policies map[policyID]*PolicyNode
 	// InheritedPolicies stores policies inherited by this Gateway.
@@ -171,6 +236,13 @@ type GatewayNode struct {
 	// EffectivePolicies reflects the effective policies applicable to this Gateway,
 	// considering inheritance and hierarchy.
 	EffectivePolicies map[policymanager.PolicyCrdID]policymanager.Policy
+	// ListenerBindings records the binding result of every route parentRef
+	// that referenced this Gateway - including rejections - keyed by the
+	// parentRef's sectionName as written, so `gwctl describe gateway` can
+	// explain why a route isn't attached. A parentRef without a sectionName
+	// is recorded under "", and one naming a listener the Gateway lacks is
+	// recorded under that name.
+	ListenerBindings map[gatewayv1.SectionName][]binding.ParentRefBindingResult
 	// Errors contains any errorrs associated with this resource.
 	Errors []error
 }
@@ -179,9 +251,14 @@ func NewGatewayNode(gateway *gatewayv1.Gateway) *GatewayNode {
 	return &GatewayNode{
 		Gateway:           gateway,
 		HTTPRoutes:        make(map[httpRouteID]*HTTPRouteNode),
+		GRPCRoutes:        make(map[grpcRouteID]*GRPCRouteNode),
+		TCPRoutes:         make(map[tcpRouteID]*TCPRouteNode),
+		TLSRoutes:         make(map[tlsRouteID]*TLSRouteNode),
+		UDPRoutes:         make(map[udpRouteID]*UDPRouteNode),
 		Policies:          make(map[policyID]*PolicyNode),
 		InheritedPolicies: make(map[policyID]*PolicyNode),
 		EffectivePolicies: make(map[policymanager.PolicyCrdID]policymanager.Policy),
+		ListenerBindings:  make(map[gatewayv1.SectionName][]binding.ParentRefBindingResult),
 		Errors:            []error{},
 	}
 }
@@ -216,6 +293,9 @@ type HTTPRouteNode struct {
 	// EffectivePolicies reflects the effective policies applicable to this
 	// HTTPRoute, mapped per Gateway for context-specific enforcement.
 	EffectivePolicies map[gatewayID]map[policymanager.PolicyCrdID]policymanager.Policy
+	// BindingResults records, per Gateway this route's parentRefs reference,
+	// whether the route was Accepted and, if not, the concrete reason why.
+	BindingResults map[gatewayID]binding.RouteBindingResult
 	// Errors contains any errorrs associated with this resource.
 	Errors []error
 }
@@ -228,6 +308,7 @@ func NewHTTPRouteNode(httpRoute *gatewayv1.HTTPRoute) *HTTPRouteNode {
 		Policies:          make(map[policyID]*PolicyNode),
 		InheritedPolicies: make(map[policyID]*PolicyNode),
 		EffectivePolicies: make(map[gatewayID]map[policymanager.PolicyCrdID]policymanager.Policy),
+		BindingResults:    make(map[gatewayID]binding.RouteBindingResult),
 		Errors:            []error{},
 	}
 }
@@ -242,6 +323,206 @@ func (h *HTTPRouteNode) ID() httpRouteID { //nolint:revive
 	return HTTPRouteID(h.HTTPRoute.GetNamespace(), h.HTTPRoute.GetName())
 }
 
+// GRPCRouteNode models the relationships and dependencies of a GRPCRoute
+// resource.
+type GRPCRouteNode struct {
+	// GRPCRoute references the actual GRPCRoute resource.
+	GRPCRoute *gatewayv1.GRPCRoute
+
+	// Namespace is the namespace of the GRPCRoute.
+	Namespace *NamespaceNode
+	// Gateways stores Gateways which this GRPCRoute is attached to.
+	Gateways map[gatewayID]*GatewayNode
+	// Backends lists Backends serving as target endpoints for traffic through
+	// this route.
+	Backends map[backendID]*BackendNode
+	// Policies stores Policies directly applied to the GRPCRoute.
+	Policies map[policyID]*PolicyNode
+	// InheritedPolicies stores policies inherited by this GRPCRoute.
+	InheritedPolicies map[policyID]*PolicyNode
+	// EffectivePolicies reflects the effective policies applicable to this
+	// GRPCRoute, mapped per Gateway for context-specific enforcement.
+	EffectivePolicies map[gatewayID]map[policymanager.PolicyCrdID]policymanager.Policy
+	// BindingResults records, per Gateway this route's parentRefs reference,
+	// whether the route was Accepted and, if not, the concrete reason why.
+	BindingResults map[gatewayID]binding.RouteBindingResult
+	// Errors contains any errorrs associated with this resource.
+	Errors []error
+}
+
+func NewGRPCRouteNode(grpcRoute *gatewayv1.GRPCRoute) *GRPCRouteNode {
+	return &GRPCRouteNode{
+		GRPCRoute:         grpcRoute,
+		Gateways:          make(map[gatewayID]*GatewayNode),
+		Backends:          make(map[backendID]*BackendNode),
+		Policies:          make(map[policyID]*PolicyNode),
+		InheritedPolicies: make(map[policyID]*PolicyNode),
+		EffectivePolicies: make(map[gatewayID]map[policymanager.PolicyCrdID]policymanager.Policy),
+		BindingResults:    make(map[gatewayID]binding.RouteBindingResult),
+		Errors:            []error{},
+	}
+}
+
+func (g GRPCRouteNode) ClientObject() client.Object { return g.GRPCRoute }
+
+func (g *GRPCRouteNode) ID() grpcRouteID { //nolint:revive
+	if g.GRPCRoute == nil {
+		klog.V(0).ErrorS(nil, "returning empty ID since GRPCRoute is nil")
+		return grpcRouteID(resourceID{})
+	}
+	return GRPCRouteID(g.GRPCRoute.GetNamespace(), g.GRPCRoute.GetName())
+}
+
+// TCPRouteNode models the relationships and dependencies of a TCPRoute
+// resource.
+type TCPRouteNode struct {
+	// TCPRoute references the actual TCPRoute resource.
+	TCPRoute *gatewayv1alpha2.TCPRoute
+
+	// Namespace is the namespace of the TCPRoute.
+	Namespace *NamespaceNode
+	// Gateways stores Gateways which this TCPRoute is attached to.
+	Gateways map[gatewayID]*GatewayNode
+	// Backends lists Backends serving as target endpoints for traffic through
+	// this route.
+	Backends map[backendID]*BackendNode
+	// Policies stores Policies directly applied to the TCPRoute.
+	Policies map[policyID]*PolicyNode
+	// InheritedPolicies stores policies inherited by this TCPRoute.
+	InheritedPolicies map[policyID]*PolicyNode
+	// EffectivePolicies reflects the effective policies applicable to this
+	// TCPRoute, mapped per Gateway for context-specific enforcement.
+	EffectivePolicies map[gatewayID]map[policymanager.PolicyCrdID]policymanager.Policy
+	// BindingResults records, per Gateway this route's parentRefs reference,
+	// whether the route was Accepted and, if not, the concrete reason why.
+	BindingResults map[gatewayID]binding.RouteBindingResult
+	// Errors contains any errorrs associated with this resource.
+	Errors []error
+}
+
+func NewTCPRouteNode(tcpRoute *gatewayv1alpha2.TCPRoute) *TCPRouteNode {
+	return &TCPRouteNode{
+		TCPRoute:          tcpRoute,
+		Gateways:          make(map[gatewayID]*GatewayNode),
+		Backends:          make(map[backendID]*BackendNode),
+		Policies:          make(map[policyID]*PolicyNode),
+		InheritedPolicies: make(map[policyID]*PolicyNode),
+		EffectivePolicies: make(map[gatewayID]map[policymanager.PolicyCrdID]policymanager.Policy),
+		BindingResults:    make(map[gatewayID]binding.RouteBindingResult),
+		Errors:            []error{},
+	}
+}
+
+func (t TCPRouteNode) ClientObject() client.Object { return t.TCPRoute }
+
+func (t *TCPRouteNode) ID() tcpRouteID { //nolint:revive
+	if t.TCPRoute == nil {
+		klog.V(0).ErrorS(nil, "returning empty ID since TCPRoute is nil")
+		return tcpRouteID(resourceID{})
+	}
+	return TCPRouteID(t.TCPRoute.GetNamespace(), t.TCPRoute.GetName())
+}
+
+// TLSRouteNode models the relationships and dependencies of a TLSRoute
+// resource.
+type TLSRouteNode struct {
+	// TLSRoute references the actual TLSRoute resource.
+	TLSRoute *gatewayv1alpha2.TLSRoute
+
+	// Namespace is the namespace of the TLSRoute.
+	Namespace *NamespaceNode
+	// Gateways stores Gateways which this TLSRoute is attached to.
+	Gateways map[gatewayID]*GatewayNode
+	// Backends lists Backends serving as target endpoints for traffic through
+	// this route.
+	Backends map[backendID]*BackendNode
+	// Policies stores Policies directly applied to the TLSRoute.
+	Policies map[policyID]*PolicyNode
+	// InheritedPolicies stores policies inherited by this TLSRoute.
+	InheritedPolicies map[policyID]*PolicyNode
+	// EffectivePolicies reflects the effective policies applicable to this
+	// TLSRoute, mapped per Gateway for context-specific enforcement.
+	EffectivePolicies map[gatewayID]map[policymanager.PolicyCrdID]policymanager.Policy
+	// BindingResults records, per Gateway this route's parentRefs reference,
+	// whether the route was Accepted and, if not, the concrete reason why.
+	BindingResults map[gatewayID]binding.RouteBindingResult
+	// Errors contains any errorrs associated with this resource.
+	Errors []error
+}
+
+func NewTLSRouteNode(tlsRoute *gatewayv1alpha2.TLSRoute) *TLSRouteNode {
+	return &TLSRouteNode{
+		TLSRoute:          tlsRoute,
+		Gateways:          make(map[gatewayID]*GatewayNode),
+		Backends:          make(map[backendID]*BackendNode),
+		Policies:          make(map[policyID]*PolicyNode),
+		InheritedPolicies: make(map[policyID]*PolicyNode),
+		EffectivePolicies: make(map[gatewayID]map[policymanager.PolicyCrdID]policymanager.Policy),
+		BindingResults:    make(map[gatewayID]binding.RouteBindingResult),
+		Errors:            []error{},
+	}
+}
+
+func (t TLSRouteNode) ClientObject() client.Object { return t.TLSRoute }
+
+func (t *TLSRouteNode) ID() tlsRouteID { //nolint:revive
+	if t.TLSRoute == nil {
+		klog.V(0).ErrorS(nil, "returning empty ID since TLSRoute is nil")
+		return tlsRouteID(resourceID{})
+	}
+	return TLSRouteID(t.TLSRoute.GetNamespace(), t.TLSRoute.GetName())
+}
+
+// UDPRouteNode models the relationships and dependencies of a UDPRoute
+// resource.
+type UDPRouteNode struct {
+	// UDPRoute references the actual UDPRoute resource.
+	UDPRoute *gatewayv1alpha2.UDPRoute
+
+	// Namespace is the namespace of the UDPRoute.
+	Namespace *NamespaceNode
+	// Gateways stores Gateways which this UDPRoute is attached to.
+	Gateways map[gatewayID]*GatewayNode
+	// Backends lists Backends serving as target endpoints for traffic through
+	// this route.
+	Backends map[backendID]*BackendNode
+	// Policies stores Policies directly applied to the UDPRoute.
+	Policies map[policyID]*PolicyNode
+	// InheritedPolicies stores policies inherited by this UDPRoute.
+	InheritedPolicies map[policyID]*PolicyNode
+	// EffectivePolicies reflects the effective policies applicable to this
+	// UDPRoute, mapped per Gateway for context-specific enforcement.
+	EffectivePolicies map[gatewayID]map[policymanager.PolicyCrdID]policymanager.Policy
+	// BindingResults records, per Gateway this route's parentRefs reference,
+	// whether the route was Accepted and, if not, the concrete reason why.
+	BindingResults map[gatewayID]binding.RouteBindingResult
+	// Errors contains any errorrs associated with this resource.
+	Errors []error
+}
+
+func NewUDPRouteNode(udpRoute *gatewayv1alpha2.UDPRoute) *UDPRouteNode {
+	return &UDPRouteNode{
+		UDPRoute:          udpRoute,
+		Gateways:          make(map[gatewayID]*GatewayNode),
+		Backends:          make(map[backendID]*BackendNode),
+		Policies:          make(map[policyID]*PolicyNode),
+		InheritedPolicies: make(map[policyID]*PolicyNode),
+		EffectivePolicies: make(map[gatewayID]map[policymanager.PolicyCrdID]policymanager.Policy),
+		BindingResults:    make(map[gatewayID]binding.RouteBindingResult),
+		Errors:            []error{},
+	}
+}
+
+func (u UDPRouteNode) ClientObject() client.Object { return u.UDPRoute }
+
+func (u *UDPRouteNode) ID() udpRouteID { //nolint:revive
+	if u.UDPRoute == nil {
+		klog.V(0).ErrorS(nil, "returning empty ID since UDPRoute is nil")
+		return udpRouteID(resourceID{})
+	}
+	return UDPRouteID(u.UDPRoute.GetNamespace(), u.UDPRoute.GetName())
+}
+
 // BackendNode models the relationships and dependencies of a Backend resource,
 // representing the ultimate destination for traffic directed by HTTPRoutes. It
 // serves as a generic abstraction, encompassing various underlying resource
@@ -254,10 +535,20 @@ type BackendNode struct {
 	Namespace *NamespaceNode
 	// HTTPRoutes lists HTTPRoutes that reference this Backend as a target.
 	HTTPRoutes map[httpRouteID]*HTTPRouteNode
+	// GRPCRoutes lists GRPCRoutes that reference this Backend as a target.
+	GRPCRoutes map[grpcRouteID]*GRPCRouteNode
+	// TCPRoutes lists TCPRoutes that reference this Backend as a target.
+	TCPRoutes map[tcpRouteID]*TCPRouteNode
+	// TLSRoutes lists TLSRoutes that reference this Backend as a target.
+	TLSRoutes map[tlsRouteID]*TLSRouteNode
+	// UDPRoutes lists UDPRoutes that reference this Backend as a target.
+	UDPRoutes map[udpRouteID]*UDPRouteNode
 	// Policies stores Policies directly applied to the Backend.
 	Policies map[policyID]*PolicyNode
 	// ReferenceGrants contains ReferenceGrants that expose this Backend.
 	ReferenceGrants map[referenceGrantID]*ReferenceGrantNode
+	// BackendTLSPolicies contains BackendTLSPolicies that target this Backend.
+	BackendTLSPolicies map[backendTLSPolicyID]*BackendTLSPolicyNode
 	// InheritedPolicies stores policies inherited by this Backend.
 	InheritedPolicies map[policyID]*PolicyNode
 	// EffectivePolicies reflects the effective policies applicable to this
@@ -269,13 +560,18 @@ type BackendNode struct {
 
 func NewBackendNode(backend *unstructured.Unstructured) *BackendNode {
 	return &BackendNode{
-		Backend:           backend,
-		HTTPRoutes:        make(map[httpRouteID]*HTTPRouteNode),
-		Policies:          make(map[policyID]*PolicyNode),
-		ReferenceGrants:   make(map[referenceGrantID]*ReferenceGrantNode),
-		InheritedPolicies: make(map[policyID]*PolicyNode),
-		EffectivePolicies: make(map[gatewayID]map[policymanager.PolicyCrdID]policymanager.Policy),
-		Errors:            []error{},
+		Backend:            backend,
+		HTTPRoutes:         make(map[httpRouteID]*HTTPRouteNode),
+		GRPCRoutes:         make(map[grpcRouteID]*GRPCRouteNode),
+		TCPRoutes:          make(map[tcpRouteID]*TCPRouteNode),
+		TLSRoutes:          make(map[tlsRouteID]*TLSRouteNode),
+		UDPRoutes:          make(map[udpRouteID]*UDPRouteNode),
+		Policies:           make(map[policyID]*PolicyNode),
+		ReferenceGrants:    make(map[referenceGrantID]*ReferenceGrantNode),
+		BackendTLSPolicies: make(map[backendTLSPolicyID]*BackendTLSPolicyNode),
+		InheritedPolicies:  make(map[policyID]*PolicyNode),
+		EffectivePolicies:  make(map[gatewayID]map[policymanager.PolicyCrdID]policymanager.Policy),
+		Errors:             []error{},
 	}
 }
 
@@ -303,8 +599,21 @@ type NamespaceNode struct {
 	Gateways map[gatewayID]*GatewayNode
 	// HTTPRoutes lists HTTPRoutes configured within the Namespace.
 	HTTPRoutes map[httpRouteID]*HTTPRouteNode
+	// GRPCRoutes lists GRPCRoutes configured within the Namespace.
+	GRPCRoutes map[grpcRouteID]*GRPCRouteNode
+	// TCPRoutes lists TCPRoutes configured within the Namespace.
+	TCPRoutes map[tcpRouteID]*TCPRouteNode
+	// TLSRoutes lists TLSRoutes configured within the Namespace.
+	TLSRoutes map[tlsRouteID]*TLSRouteNode
+	// UDPRoutes lists UDPRoutes configured within the Namespace.
+	UDPRoutes map[udpRouteID]*UDPRouteNode
 	// Backends lists Backends residing within the Namespace.
 	Backends map[backendID]*BackendNode
+	// ConfigMaps lists ConfigMaps residing within the Namespace.
+	ConfigMaps map[configMapID]*ConfigMapNode
+	// BackendTLSPolicies lists BackendTLSPolicies configured within the
+	// Namespace.
+	BackendTLSPolicies map[backendTLSPolicyID]*BackendTLSPolicyNode
 	// Policies stores Policies directly applied to the Namespace.
 	Policies map[policyID]*PolicyNode
 }
@@ -314,11 +623,17 @@ func NewNamespaceNode(namespace corev1.Namespace) *NamespaceNode {
 		namespace.Name = metav1.NamespaceDefault
 	}
 	return &NamespaceNode{
-		Namespace:  &namespace,
-		Gateways:   make(map[gatewayID]*GatewayNode),
-		HTTPRoutes: make(map[httpRouteID]*HTTPRouteNode),
-		Backends:   make(map[backendID]*BackendNode),
-		Policies:   make(map[policyID]*PolicyNode),
+		Namespace:          &namespace,
+		Gateways:           make(map[gatewayID]*GatewayNode),
+		HTTPRoutes:         make(map[httpRouteID]*HTTPRouteNode),
+		GRPCRoutes:         make(map[grpcRouteID]*GRPCRouteNode),
+		TCPRoutes:          make(map[tcpRouteID]*TCPRouteNode),
+		TLSRoutes:          make(map[tlsRouteID]*TLSRouteNode),
+		UDPRoutes:          make(map[udpRouteID]*UDPRouteNode),
+		Backends:           make(map[backendID]*BackendNode),
+		ConfigMaps:         make(map[configMapID]*ConfigMapNode),
+		BackendTLSPolicies: make(map[backendTLSPolicyID]*BackendTLSPolicyNode),
+		Policies:           make(map[policyID]*PolicyNode),
 	}
 }
 
@@ -356,6 +671,92 @@ func (r *ReferenceGrantNode) ID() referenceGrantID { //nolint:revive
 	return ReferenceGrantID(r.ReferenceGrant.GetNamespace(), r.ReferenceGrant.GetName())
 }
 
+// ConfigMapNode models the relationships and dependencies of a ConfigMap
+// resource, namely the CA certificate bundles referenced by
+// BackendTLSPolicies.
+type ConfigMapNode struct {
+	// ConfigMap references the actual ConfigMap resource.
+	ConfigMap *corev1.ConfigMap
+
+	// Namespace is the namespace of the ConfigMap.
+	Namespace *NamespaceNode
+	// BackendTLSPolicies lists BackendTLSPolicies that reference this
+	// ConfigMap as a source of CA certificates.
+	BackendTLSPolicies map[backendTLSPolicyID]*BackendTLSPolicyNode
+}
+
+func NewConfigMapNode(configMap *corev1.ConfigMap) *ConfigMapNode {
+	return &ConfigMapNode{
+		ConfigMap:          configMap,
+		BackendTLSPolicies: make(map[backendTLSPolicyID]*BackendTLSPolicyNode),
+	}
+}
+
+func (c ConfigMapNode) ClientObject() client.Object { return c.ConfigMap }
+
+func (c *ConfigMapNode) ID() configMapID { //nolint:revive
+	if c.ConfigMap == nil {
+		klog.V(0).ErrorS(nil, "returning empty ID since ConfigMap is nil")
+		return configMapID(resourceID{})
+	}
+	return ConfigMapID(c.ConfigMap.GetNamespace(), c.ConfigMap.GetName())
+}
+
+// BackendTLSPolicyNode models the relationships and dependencies of a
+// BackendTLSPolicy resource, which configures TLS validation for
+// connections from a Gateway to a Backend.
+type BackendTLSPolicyNode struct {
+	// BackendTLSPolicy references the actual BackendTLSPolicy resource.
+	BackendTLSPolicy *gatewayv1alpha3.BackendTLSPolicy
+
+	// Namespace is the namespace of the BackendTLSPolicy.
+	Namespace *NamespaceNode
+	// Backends stores the Backends targeted by this BackendTLSPolicy via
+	// spec.targetRefs.
+	Backends map[backendID]*BackendNode
+	// CACertRefs stores the ConfigMaps referenced by this BackendTLSPolicy as
+	// sources of CA certificates.
+	CACertRefs map[configMapID]*ConfigMapNode
+	// Errors contains any errorrs associated with this resource, such as an
+	// unresolvable targetRef or CA certificate reference.
+	Errors []error
+}
+
+func NewBackendTLSPolicyNode(backendTLSPolicy *gatewayv1alpha3.BackendTLSPolicy) *BackendTLSPolicyNode {
+	return &BackendTLSPolicyNode{
+		BackendTLSPolicy: backendTLSPolicy,
+		Backends:         make(map[backendID]*BackendNode),
+		CACertRefs:       make(map[configMapID]*ConfigMapNode),
+		Errors:           []error{},
+	}
+}
+
+func (b BackendTLSPolicyNode) ClientObject() client.Object { return b.BackendTLSPolicy }
+
+func (b *BackendTLSPolicyNode) ID() backendTLSPolicyID { //nolint:revive
+	if b.BackendTLSPolicy == nil {
+		klog.V(0).ErrorS(nil, "returning empty ID since BackendTLSPolicy is nil")
+		return backendTLSPolicyID(resourceID{})
+	}
+	return BackendTLSPolicyID(b.BackendTLSPolicy.GetNamespace(), b.BackendTLSPolicy.GetName())
+}
+
+// Referrer is implemented by policies that advertise their relationship to a
+// target via annotations, so that discovery can resolve the policy<->target
+// link without having to enumerate every policy CRD in the cluster first.
+// This mirrors the direct-reference/back-reference annotation convention
+// used by Kuadrant policies.
+type Referrer interface {
+	// DirectReferenceAnnotationName returns the annotation key that a policy
+	// places on its target(s) to advertise that it directly applies to them.
+	DirectReferenceAnnotationName() string
+	// BackReferenceAnnotationName returns the annotation key that a target
+	// uses to list the policies (direct or inherited) that apply to it.
+	BackReferenceAnnotationName() string
+	// TargetRefs returns the targetRefs declared in the policy's spec.
+	TargetRefs() []gatewayv1alpha2.PolicyTargetReference
+}
+
 // PolicyNode models the relationships and dependencies of a Policy resource
 type PolicyNode struct {
 	// Policy references the actual Policy resource.
@@ -376,14 +777,35 @@ type PolicyNode struct {
 	// attached. It's nil if the policy is not associated with a specific
 	// HTTPRoute.
 	HTTPRoute *HTTPRouteNode
+	// GRPCRoute references the GRPCRouteNode to which the policy is directly
+	// attached. It's nil if the policy is not associated with a specific
+	// GRPCRoute.
+	GRPCRoute *GRPCRouteNode
+	// TCPRoute references the TCPRouteNode to which the policy is directly
+	// attached. It's nil if the policy is not associated with a specific
+	// TCPRoute.
+	TCPRoute *TCPRouteNode
+	// TLSRoute references the TLSRouteNode to which the policy is directly
+	// attached. It's nil if the policy is not associated with a specific
+	// TLSRoute.
+	TLSRoute *TLSRouteNode
+	// UDPRoute references the UDPRouteNode to which the policy is directly
+	// attached. It's nil if the policy is not associated with a specific
+	// UDPRoute.
+	UDPRoute *UDPRouteNode
 	// Backend references the BackendNode to which the policy is directly
 	// attached. It's nil if the policy is not associated with a specific Backend.
 	Backend *BackendNode
+	// Errors contains any errorrs associated with this resource, including
+	// drift detected between a target's back-reference annotation and the
+	// policy's live spec.
+	Errors []error
 }
 
 func NewPolicyNode(policy *policymanager.Policy) *PolicyNode {
 	return &PolicyNode{
 		Policy: policy,
+		Errors: []error{},
 	}
 }
 
@@ -0,0 +1,159 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcediscovery
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// referrerAnnotationDomain is the annotation domain used for the
+// direct-reference/back-reference annotations, mirroring
+// "policy.kuadrant.io" in Kuadrant's own policies.
+const referrerAnnotationDomain = "policy.gateway.networking.k8s.io"
+
+var _ Referrer = (*PolicyNode)(nil)
+
+// DirectReferenceAnnotationName returns the annotation key that a policy of
+// this Kind places on its target(s) to advertise that it directly applies to
+// them, e.g. "policy.gateway.networking.k8s.io/httproutetimeoutpolicy".
+func (p *PolicyNode) DirectReferenceAnnotationName() string {
+	return fmt.Sprintf("%s/%s", referrerAnnotationDomain, strings.ToLower(p.policyKind()))
+}
+
+// BackReferenceAnnotationName returns the annotation key that a target uses
+// to list the policies (direct or inherited) of this Kind that apply to it,
+// e.g. "policy.gateway.networking.k8s.io/httproutetimeoutpolicy-referred-by".
+func (p *PolicyNode) BackReferenceAnnotationName() string {
+	return fmt.Sprintf("%s/%s-referred-by", referrerAnnotationDomain, strings.ToLower(p.policyKind()))
+}
+
+// TargetRefs returns the targetRefs declared in the policy's spec, read
+// directly off the underlying unstructured object since policy CRDs don't
+// share a common Go type.
+func (p *PolicyNode) TargetRefs() []gatewayv1alpha2.PolicyTargetReference {
+	if p.Policy == nil {
+		return nil
+	}
+
+	obj := p.Policy.Unstructured()
+	var refs []gatewayv1alpha2.PolicyTargetReference
+
+	if singular, found, _ := unstructured.NestedMap(obj.Object, "spec", "targetRef"); found {
+		if ref, ok := toPolicyTargetReference(singular); ok {
+			refs = append(refs, ref)
+		}
+	}
+
+	if list, found, _ := unstructured.NestedSlice(obj.Object, "spec", "targetRefs"); found {
+		for _, entry := range list {
+			m, ok := entry.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if ref, ok := toPolicyTargetReference(m); ok {
+				refs = append(refs, ref)
+			}
+		}
+	}
+
+	return refs
+}
+
+func toPolicyTargetReference(m map[string]interface{}) (gatewayv1alpha2.PolicyTargetReference, bool) {
+	name, _, _ := unstructured.NestedString(m, "name")
+	if name == "" {
+		return gatewayv1alpha2.PolicyTargetReference{}, false
+	}
+	group, _, _ := unstructured.NestedString(m, "group")
+	kind, _, _ := unstructured.NestedString(m, "kind")
+	namespace, _, _ := unstructured.NestedString(m, "namespace")
+
+	ref := gatewayv1alpha2.PolicyTargetReference{
+		Group: gatewayv1alpha2.Group(group),
+		Kind:  gatewayv1alpha2.Kind(kind),
+		Name:  gatewayv1alpha2.ObjectName(name),
+	}
+	if namespace != "" {
+		ns := gatewayv1alpha2.Namespace(namespace)
+		ref.Namespace = &ns
+	}
+	return ref, true
+}
+
+func (p *PolicyNode) policyKind() string {
+	if p.Policy == nil {
+		return ""
+	}
+	return p.Policy.Unstructured().GetKind()
+}
+
+// ResolveDirectReferencePolicyNames reads policyNode's
+// DirectReferenceAnnotationName off target's annotations and returns the
+// policy names it advertises there. This lets discovery find a policy that
+// directly applies to target before the policy object itself has been
+// listed, instead of having to enumerate every policy CRD up front.
+func ResolveDirectReferencePolicyNames(policyNode *PolicyNode, target client.Object) []string {
+	value := target.GetAnnotations()[policyNode.DirectReferenceAnnotationName()]
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
+
+// PopulateBackReference records policyNode as applying to target in
+// policies (the target node's reverse Policies map), and checks that
+// target's back-reference annotation agrees that policyNode applies to it.
+// Disagreement is recorded on policyNode.Errors rather than failing
+// discovery outright, since the annotation and the live policy spec can
+// drift independently of each other.
+func PopulateBackReference(policyNode *PolicyNode, target client.Object, policies map[policyID]*PolicyNode) {
+	policies[policyNode.ID()] = policyNode
+
+	policyName := policyNode.Policy.Unstructured().GetName()
+	backReferenced := strings.Split(target.GetAnnotations()[policyNode.BackReferenceAnnotationName()], ",")
+
+	for _, name := range backReferenced {
+		if name == policyName {
+			return
+		}
+	}
+
+	policyNode.Errors = append(policyNode.Errors, fmt.Errorf(
+		"policy %s/%s targets %s/%s but is missing from its %q back-reference annotation (found: %s)",
+		policyNode.Policy.Unstructured().GetNamespace(), policyName,
+		target.GetNamespace(), target.GetName(),
+		policyNode.BackReferenceAnnotationName(), strings.Join(sortedNonEmpty(backReferenced), ",")))
+}
+
+func sortedNonEmpty(values []string) []string {
+	var out []string
+	for _, v := range values {
+		if v != "" {
+			out = append(out, v)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
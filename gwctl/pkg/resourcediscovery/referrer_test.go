@@ -0,0 +1,47 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcediscovery
+
+import "testing"
+
+func TestToPolicyTargetReference(t *testing.T) {
+	ref, ok := toPolicyTargetReference(map[string]interface{}{
+		"group": "gateway.networking.k8s.io",
+		"kind":  "Gateway",
+		"name":  "my-gateway",
+	})
+	if !ok {
+		t.Fatalf("expected a targetRef to be parsed")
+	}
+	if string(ref.Group) != "gateway.networking.k8s.io" || string(ref.Kind) != "Gateway" || string(ref.Name) != "my-gateway" {
+		t.Errorf("unexpected targetRef: %+v", ref)
+	}
+	if ref.Namespace != nil {
+		t.Errorf("expected no namespace, got %v", *ref.Namespace)
+	}
+
+	if _, ok := toPolicyTargetReference(map[string]interface{}{"kind": "Gateway"}); ok {
+		t.Errorf("expected a targetRef without a name to be rejected")
+	}
+}
+
+func TestSortedNonEmpty(t *testing.T) {
+	got := sortedNonEmpty([]string{"b", "", "a", ""})
+	if len(got) != 2 || got[0] != "a" || got[1] != "b" {
+		t.Errorf("sortedNonEmpty = %v, want [a b]", got)
+	}
+}
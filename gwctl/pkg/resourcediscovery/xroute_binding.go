@@ -0,0 +1,167 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcediscovery
+
+import (
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/gateway-api/gwctl/pkg/resourcediscovery/binding"
+)
+
+// PopulateGRPCRouteBindingResults evaluates grpcRouteNode against every
+// Gateway it's attached to, the GRPCRoute analogue of
+// PopulateHTTPRouteBindingResults.
+func PopulateGRPCRouteBindingResults(grpcRouteNode *GRPCRouteNode, refGrants []*gatewayv1beta1.ReferenceGrant) {
+	if grpcRouteNode.GRPCRoute == nil {
+		return
+	}
+
+	routeContext := binding.RouteContext{
+		Route: binding.RouteRef{
+			Group:     gatewayv1.GroupName,
+			Kind:      "GRPCRoute",
+			Namespace: grpcRouteNode.GRPCRoute.Namespace,
+			Name:      grpcRouteNode.GRPCRoute.Name,
+		},
+		Hostnames:  grpcRouteNode.GRPCRoute.Spec.Hostnames,
+		ParentRefs: grpcRouteNode.GRPCRoute.Spec.ParentRefs,
+	}
+
+	var backendRefs []binding.BackendRefContext
+	for _, rule := range grpcRouteNode.GRPCRoute.Spec.Rules {
+		for _, backendRef := range rule.BackendRefs {
+			backendRefs = append(backendRefs, resolveBackendRefContext(backendRef.BackendRef, grpcRouteNode.GRPCRoute.Namespace, grpcRouteNode.Backends))
+		}
+	}
+
+	evaluateAndStoreBindings(routeContext, backendRefs, refGrants, grpcRouteNode.Gateways, func(gwID gatewayID, result binding.RouteBindingResult) {
+		grpcRouteNode.BindingResults[gwID] = result
+	})
+}
+
+// PopulateTCPRouteBindingResults evaluates tcpRouteNode against every
+// Gateway it's attached to, the TCPRoute analogue of
+// PopulateHTTPRouteBindingResults. TCPRoute has no Hostnames field, so
+// hostname intersection is a no-op for it.
+func PopulateTCPRouteBindingResults(tcpRouteNode *TCPRouteNode, refGrants []*gatewayv1beta1.ReferenceGrant) {
+	if tcpRouteNode.TCPRoute == nil {
+		return
+	}
+
+	routeContext := binding.RouteContext{
+		Route: binding.RouteRef{
+			Group:     gatewayv1.GroupName,
+			Kind:      "TCPRoute",
+			Namespace: tcpRouteNode.TCPRoute.Namespace,
+			Name:      tcpRouteNode.TCPRoute.Name,
+		},
+		ParentRefs: tcpRouteNode.TCPRoute.Spec.ParentRefs,
+	}
+
+	var backendRefs []binding.BackendRefContext
+	for _, rule := range tcpRouteNode.TCPRoute.Spec.Rules {
+		for _, backendRef := range rule.BackendRefs {
+			backendRefs = append(backendRefs, resolveBackendRefContext(backendRef, tcpRouteNode.TCPRoute.Namespace, tcpRouteNode.Backends))
+		}
+	}
+
+	evaluateAndStoreBindings(routeContext, backendRefs, refGrants, tcpRouteNode.Gateways, func(gwID gatewayID, result binding.RouteBindingResult) {
+		tcpRouteNode.BindingResults[gwID] = result
+	})
+}
+
+// PopulateTLSRouteBindingResults evaluates tlsRouteNode against every
+// Gateway it's attached to, the TLSRoute analogue of
+// PopulateHTTPRouteBindingResults.
+func PopulateTLSRouteBindingResults(tlsRouteNode *TLSRouteNode, refGrants []*gatewayv1beta1.ReferenceGrant) {
+	if tlsRouteNode.TLSRoute == nil {
+		return
+	}
+
+	routeContext := binding.RouteContext{
+		Route: binding.RouteRef{
+			Group:     gatewayv1.GroupName,
+			Kind:      "TLSRoute",
+			Namespace: tlsRouteNode.TLSRoute.Namespace,
+			Name:      tlsRouteNode.TLSRoute.Name,
+		},
+		Hostnames:  tlsRouteNode.TLSRoute.Spec.Hostnames,
+		ParentRefs: tlsRouteNode.TLSRoute.Spec.ParentRefs,
+	}
+
+	var backendRefs []binding.BackendRefContext
+	for _, rule := range tlsRouteNode.TLSRoute.Spec.Rules {
+		for _, backendRef := range rule.BackendRefs {
+			backendRefs = append(backendRefs, resolveBackendRefContext(backendRef, tlsRouteNode.TLSRoute.Namespace, tlsRouteNode.Backends))
+		}
+	}
+
+	evaluateAndStoreBindings(routeContext, backendRefs, refGrants, tlsRouteNode.Gateways, func(gwID gatewayID, result binding.RouteBindingResult) {
+		tlsRouteNode.BindingResults[gwID] = result
+	})
+}
+
+// PopulateUDPRouteBindingResults evaluates udpRouteNode against every
+// Gateway it's attached to, the UDPRoute analogue of
+// PopulateHTTPRouteBindingResults. UDPRoute has no Hostnames field, so
+// hostname intersection is a no-op for it.
+func PopulateUDPRouteBindingResults(udpRouteNode *UDPRouteNode, refGrants []*gatewayv1beta1.ReferenceGrant) {
+	if udpRouteNode.UDPRoute == nil {
+		return
+	}
+
+	routeContext := binding.RouteContext{
+		Route: binding.RouteRef{
+			Group:     gatewayv1.GroupName,
+			Kind:      "UDPRoute",
+			Namespace: udpRouteNode.UDPRoute.Namespace,
+			Name:      udpRouteNode.UDPRoute.Name,
+		},
+		ParentRefs: udpRouteNode.UDPRoute.Spec.ParentRefs,
+	}
+
+	var backendRefs []binding.BackendRefContext
+	for _, rule := range udpRouteNode.UDPRoute.Spec.Rules {
+		for _, backendRef := range rule.BackendRefs {
+			backendRefs = append(backendRefs, resolveBackendRefContext(backendRef, udpRouteNode.UDPRoute.Namespace, udpRouteNode.Backends))
+		}
+	}
+
+	evaluateAndStoreBindings(routeContext, backendRefs, refGrants, udpRouteNode.Gateways, func(gwID gatewayID, result binding.RouteBindingResult) {
+		udpRouteNode.BindingResults[gwID] = result
+	})
+}
+
+// resolveBackendRefContext resolves backendRef against the Backends already
+// discovered for a route, defaulting its namespace and Kind the way Gateway
+// API does.
+func resolveBackendRefContext(backendRef gatewayv1.BackendRef, routeNamespace string, backends map[backendID]*BackendNode) binding.BackendRefContext {
+	namespace := routeNamespace
+	if backendRef.Namespace != nil {
+		namespace = string(*backendRef.Namespace)
+	}
+	group, kind := "", "Service"
+	if backendRef.Group != nil {
+		group = string(*backendRef.Group)
+	}
+	if backendRef.Kind != nil {
+		kind = string(*backendRef.Kind)
+	}
+
+	_, found := backends[BackendID(group, kind, namespace, string(backendRef.Name))]
+	return binding.BackendRefContext{BackendRef: backendRef, Found: found}
+}
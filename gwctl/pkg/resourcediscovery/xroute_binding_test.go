@@ -0,0 +1,111 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcediscovery
+
+import (
+	"testing"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPopulateGRPCRouteBindingResults(t *testing.T) {
+	sectionName := gatewayv1.SectionName("grpc")
+	fromAll := gatewayv1.NamespacesFromAll
+	gateway := NewGatewayNode(&gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "infra", Name: "gw"},
+		Spec: gatewayv1.GatewaySpec{
+			Listeners: []gatewayv1.Listener{{
+				Name:     sectionName,
+				Protocol: gatewayv1.HTTPSProtocolType,
+				AllowedRoutes: &gatewayv1.AllowedRoutes{
+					Namespaces: &gatewayv1.RouteNamespaces{From: &fromAll},
+				},
+			}},
+		},
+	})
+
+	namespace := gatewayv1.Namespace("infra")
+	grpcRoute := NewGRPCRouteNode(&gatewayv1.GRPCRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "my-route"},
+		Spec: gatewayv1.GRPCRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{
+				ParentRefs: []gatewayv1.ParentReference{{
+					Name:        "gw",
+					Namespace:   &namespace,
+					SectionName: &sectionName,
+				}},
+			},
+		},
+	})
+	grpcRoute.Gateways[gateway.ID()] = gateway
+
+	PopulateGRPCRouteBindingResults(grpcRoute, nil)
+
+	result, ok := grpcRoute.BindingResults[gateway.ID()]
+	if !ok {
+		t.Fatalf("expected a BindingResult keyed by the Gateway's ID")
+	}
+	if len(result.ParentRefs) != 1 || !result.ParentRefs[0].Accepted {
+		t.Fatalf("expected the route to be accepted by the Gateway, got %+v", result.ParentRefs)
+	}
+
+	listenerBindings := gateway.ListenerBindings[sectionName]
+	if len(listenerBindings) != 1 || !listenerBindings[0].Accepted {
+		t.Fatalf("expected GatewayNode.ListenerBindings[%q] to record the accepted route, got %+v", sectionName, listenerBindings)
+	}
+}
+
+func TestPopulateTCPRouteBindingResults_RejectsWrongSection(t *testing.T) {
+	gateway := NewGatewayNode(&gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "infra", Name: "gw"},
+		Spec: gatewayv1.GatewaySpec{
+			Listeners: []gatewayv1.Listener{{
+				Name:     "tcp",
+				Protocol: gatewayv1.TCPProtocolType,
+			}},
+		},
+	})
+
+	namespace := gatewayv1.Namespace("infra")
+	missingSection := gatewayv1.SectionName("does-not-exist")
+	tcpRoute := NewTCPRouteNode(&gatewayv1alpha2.TCPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "infra", Name: "my-route"},
+		Spec: gatewayv1alpha2.TCPRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{
+				ParentRefs: []gatewayv1.ParentReference{{
+					Name:        "gw",
+					Namespace:   &namespace,
+					SectionName: &missingSection,
+				}},
+			},
+		},
+	})
+	tcpRoute.Gateways[gateway.ID()] = gateway
+
+	PopulateTCPRouteBindingResults(tcpRoute, nil)
+
+	result, ok := tcpRoute.BindingResults[gateway.ID()]
+	if !ok {
+		t.Fatalf("expected a BindingResult keyed by the Gateway's ID")
+	}
+	if len(result.ParentRefs) != 1 || result.ParentRefs[0].Accepted {
+		t.Fatalf("expected the route to be rejected for naming a non-existent listener, got %+v", result.ParentRefs)
+	}
+}